@@ -1,55 +1,62 @@
 package main
 
 import (
-	"os"
-
 	"github.com/aws/aws-cdk-go/awscdk/v2"
-	// "github.com/aws/aws-cdk-go/awscdk/v2/awssqs"
-	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
-)
-
-type SkillTrailCoreStackProps struct {
-	awscdk.StackProps
-}
-
-func NewSkillTrailCoreStack(scope constructs.Construct, id string, props *SkillTrailCoreStackProps) awscdk.Stack {
-	var sprops awscdk.StackProps
-	if props != nil {
-		sprops = props.StackProps
-	}
-	stack := awscdk.NewStack(scope, &id, &sprops)
-
-	// The code that defines your stack goes here
-
-	// example resource
-	// queue := awssqs.NewQueue(stack, jsii.String("SkillTrailQueue"), &awssqs.QueueProps{
-	// 	VisibilityTimeout: awscdk.Duration_Seconds(jsii.Number(300)),
-	// })
 
-	return stack
-}
+	"github.com/ghezzofr/skilltrail-core/internal/config"
+	"github.com/ghezzofr/skilltrail-core/stacks"
+)
 
 func main() {
 	defer jsii.Close()
 
 	app := awscdk.NewApp(nil)
 
-	NewSkillTrailCoreStack(app, "SkillTrailCoreStack", &SkillTrailCoreStackProps{
-		awscdk.StackProps{
-			Env: env(),
-		},
-	})
-
-	app.Synth(nil)
-}
+	localstack := config.IsLocalstack(app)
+	pinNodeAmi := config.PinNodeAmi(app)
+
+	for _, name := range config.Selected(app) {
+		resolve := config.Resolve
+		if localstack {
+			resolve = config.ResolveLocalstack
+		}
+		env, err := resolve(app, name)
+		if err != nil {
+			panic(err)
+		}
+
+		stackEnv := &awscdk.Environment{
+			Account: jsii.String(env.Account),
+			Region:  jsii.String(env.Region),
+		}
+
+		coreStack := stacks.NewSkillTrailCoreStack(app, env.StackID("SkillTrailCore"), &stacks.SkillTrailCoreStackProps{
+			StackProps: awscdk.StackProps{Env: stackEnv},
+		})
+		awscdk.Tags_Of(coreStack).Add(jsii.String("environment"), jsii.String(string(env.Name)), nil)
+
+		platformStack := stacks.NewSkillTrailPlatformStack(app, env.StackID("SkillTrailPlatform"), &stacks.SkillTrailPlatformStackProps{
+			StackProps:           awscdk.StackProps{Env: stackEnv},
+			NodeInstanceType:     env.NodeInstanceType,
+			NodeGroupMinSize:     env.NodeGroupMinSize,
+			NodeGroupMaxSize:     env.NodeGroupMaxSize,
+			NodeGroupDesiredSize: env.NodeGroupDesiredSize,
+			VpcCidr:              env.VpcCidr,
+			Localstack:           localstack,
+			PinNodeAmi:           pinNodeAmi,
+		})
+		awscdk.Tags_Of(platformStack).Add(jsii.String("environment"), jsii.String(string(env.Name)), nil)
+	}
 
-// env determines the AWS environment (account+region) in which our stack is to
-// be deployed. For more information see: https://docs.aws.amazon.com/cdk/latest/guide/environments.html
-func env() *awscdk.Environment {
-	// Configure for eu-south-1 region using AWS CLI configuration
-	return &awscdk.Environment{
-		Account: jsii.String(os.Getenv("CDK_DEFAULT_ACCOUNT")),
-		Region:  jsii.String("eu-south-1"),
+	if connectionArn, repoString, branch := config.PipelineSource(app); connectionArn != "" {
+		stacks.NewSkillTrailPipelineStack(app, "SkillTrailCorePipeline", &stacks.SkillTrailPipelineStackProps{
+			StackProps:    awscdk.StackProps{Env: config.ToolingEnvironment()},
+			ConnectionArn: connectionArn,
+			RepoString:    repoString,
+			Branch:        branch,
+		})
 	}
+
+	app.Synth(nil)
 }
@@ -0,0 +1,229 @@
+// Package config resolves the account, region and per-environment settings
+// the stacks need, following the documented CDK environment precedence:
+// https://docs.aws.amazon.com/cdk/latest/guide/environments.html
+//
+// Precedence (highest first):
+//  1. explicit env vars (CDK_DEFAULT_ACCOUNT / CDK_DEFAULT_REGION)
+//  2. cdk.json (or a CDK --context flag) "environments" overrides for that
+//     environment
+//  3. the per-environment defaults below, for the environment selected by a
+//     --context env=dev|staging|prod value
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// Name identifies one of the deployable environments.
+type Name string
+
+const (
+	Dev     Name = "dev"
+	Staging Name = "staging"
+	Prod    Name = "prod"
+)
+
+// Environment holds the resolved settings for a single deployable
+// environment: account/region plus the sizing and feature flags the stacks
+// use to scale themselves per environment.
+type Environment struct {
+	Name    Name
+	Account string
+	Region  string
+
+	VpcCidr string
+
+	NodeInstanceType     string
+	NodeGroupMinSize     float64
+	NodeGroupMaxSize     float64
+	NodeGroupDesiredSize float64
+}
+
+// defaults holds the baseline settings for each known environment. cdk.json
+// context under "environments.<name>" can override any of these fields
+// except Name.
+var defaults = map[Name]Environment{
+	Dev: {
+		Name:                 Dev,
+		Region:               "eu-south-1",
+		VpcCidr:              "10.10.0.0/16",
+		NodeInstanceType:     "m5.large",
+		NodeGroupMinSize:     1,
+		NodeGroupMaxSize:     2,
+		NodeGroupDesiredSize: 1,
+	},
+	Staging: {
+		Name:                 Staging,
+		Region:               "eu-south-1",
+		VpcCidr:              "10.20.0.0/16",
+		NodeInstanceType:     "m5.large",
+		NodeGroupMinSize:     2,
+		NodeGroupMaxSize:     4,
+		NodeGroupDesiredSize: 2,
+	},
+	Prod: {
+		Name:                 Prod,
+		Region:               "eu-south-1",
+		VpcCidr:              "10.30.0.0/16",
+		NodeInstanceType:     "m5.xlarge",
+		NodeGroupMinSize:     3,
+		NodeGroupMaxSize:     8,
+		NodeGroupDesiredSize: 3,
+	},
+}
+
+// Resolve returns the settings for the named environment, applying cdk.json
+// context overrides and then the CDK_DEFAULT_ACCOUNT/CDK_DEFAULT_REGION env
+// vars, which always win.
+func Resolve(app awscdk.App, name Name) (Environment, error) {
+	env, ok := defaults[name]
+	if !ok {
+		return Environment{}, fmt.Errorf("config: unknown environment %q", name)
+	}
+
+	if raw := app.Node().TryGetContext(jsii.String("environments")); raw != nil {
+		if envs, ok := raw.(map[string]interface{}); ok {
+			if override, ok := envs[string(name)].(map[string]interface{}); ok {
+				applyOverrides(&env, override)
+			}
+		}
+	}
+
+	if account := os.Getenv("CDK_DEFAULT_ACCOUNT"); account != "" {
+		env.Account = account
+	}
+	if region := os.Getenv("CDK_DEFAULT_REGION"); region != "" {
+		env.Region = region
+	}
+
+	return env, nil
+}
+
+func applyOverrides(env *Environment, override map[string]interface{}) {
+	if v, ok := override["account"].(string); ok {
+		env.Account = v
+	}
+	if v, ok := override["region"].(string); ok {
+		env.Region = v
+	}
+	if v, ok := override["vpcCidr"].(string); ok {
+		env.VpcCidr = v
+	}
+	if v, ok := override["nodeInstanceType"].(string); ok {
+		env.NodeInstanceType = v
+	}
+	if v, ok := override["nodeGroupMinSize"].(float64); ok {
+		env.NodeGroupMinSize = v
+	}
+	if v, ok := override["nodeGroupMaxSize"].(float64); ok {
+		env.NodeGroupMaxSize = v
+	}
+	if v, ok := override["nodeGroupDesiredSize"].(float64); ok {
+		env.NodeGroupDesiredSize = v
+	}
+}
+
+// StackID returns the per-environment CloudFormation stack ID for baseName,
+// e.g. StackID("SkillTrailCore") -> "SkillTrailCore-dev".
+func (e Environment) StackID(baseName string) string {
+	return fmt.Sprintf("%s-%s", baseName, e.Name)
+}
+
+// Selected returns the environments main() should deploy, resolved from the
+// --context env=dev|staging|prod value. Absent that context value, every
+// known environment is deployed.
+func Selected(app awscdk.App) []Name {
+	if raw := app.Node().TryGetContext(jsii.String("env")); raw != nil {
+		if name, ok := raw.(string); ok && name != "" {
+			return []Name{Name(name)}
+		}
+	}
+	return []Name{Dev, Staging, Prod}
+}
+
+// localstackAccount and localstackRegion are the dummy account/region
+// cdklocal expects in place of real AWS values.
+const (
+	localstackAccount = "000000000000"
+	localstackRegion  = "us-east-1"
+)
+
+// IsLocalstack reports whether the app should target LocalStack instead of
+// real AWS, via --context localstack=true or SKILLTRAIL_LOCAL=1.
+func IsLocalstack(app awscdk.App) bool {
+	if raw := app.Node().TryGetContext(jsii.String("localstack")); raw != nil {
+		switch v := raw.(type) {
+		case bool:
+			if v {
+				return true
+			}
+		case string:
+			if v == "true" {
+				return true
+			}
+		}
+	}
+	return os.Getenv("SKILLTRAIL_LOCAL") == "1"
+}
+
+// PinNodeAmi reports whether the platform stack should resolve and pin the
+// node group's AMI via an SSM lookup at synth time, via --context
+// pinNodeAmi=true or SKILLTRAIL_PIN_NODE_AMI=1. It defaults to off so a
+// plain `cdk synth` doesn't require AWS credentials.
+func PinNodeAmi(app awscdk.App) bool {
+	if raw := app.Node().TryGetContext(jsii.String("pinNodeAmi")); raw != nil {
+		switch v := raw.(type) {
+		case bool:
+			if v {
+				return true
+			}
+		case string:
+			if v == "true" {
+				return true
+			}
+		}
+	}
+	return os.Getenv("SKILLTRAIL_PIN_NODE_AMI") == "1"
+}
+
+// ToolingEnvironment returns the account/region the pipeline stack itself
+// deploys to, resolved straight from CDK_DEFAULT_ACCOUNT/CDK_DEFAULT_REGION
+// rather than one of the per-environment defaults above.
+func ToolingEnvironment() *awscdk.Environment {
+	return &awscdk.Environment{
+		Account: jsii.String(os.Getenv("CDK_DEFAULT_ACCOUNT")),
+		Region:  jsii.String(os.Getenv("CDK_DEFAULT_REGION")),
+	}
+}
+
+// PipelineSource returns the CodeStar connection ARN, GitHub "owner/repo"
+// and branch used to source the delivery pipeline, read from cdk.json
+// context ("connectionArn", "repoString", "branch"). Each is empty if unset.
+func PipelineSource(app awscdk.App) (connectionArn, repoString, branch string) {
+	get := func(key string) string {
+		if raw := app.Node().TryGetContext(jsii.String(key)); raw != nil {
+			if s, ok := raw.(string); ok {
+				return s
+			}
+		}
+		return ""
+	}
+	return get("connectionArn"), get("repoString"), get("branch")
+}
+
+// ResolveLocalstack behaves like Resolve, but substitutes the dummy
+// account/region cdklocal expects so the synthesized template can be
+// deployed against LocalStack.
+func ResolveLocalstack(app awscdk.App, name Name) (Environment, error) {
+	env, err := Resolve(app, name)
+	if err != nil {
+		return Environment{}, err
+	}
+	env.Account = localstackAccount
+	env.Region = localstackRegion
+	return env, nil
+}
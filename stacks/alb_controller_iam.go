@@ -0,0 +1,107 @@
+package stacks
+
+import (
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsiam"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// albControllerPolicyStatements mirrors the IAM policy the upstream
+// aws-load-balancer-controller project documents for its controller service
+// account, so the IRSA role backing it can actually call the ELB/EC2/ACM/WAF
+// APIs it needs instead of falling back to (and being denied by) the node
+// role. See https://kubernetes-sigs.github.io/aws-load-balancer-controller/latest/deploy/installation/
+func albControllerPolicyStatements() []awsiam.PolicyStatement {
+	return []awsiam.PolicyStatement{
+		awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Effect: awsiam.Effect_ALLOW,
+			Actions: &[]*string{
+				jsii.String("iam:CreateServiceLinkedRole"),
+				jsii.String("ec2:DescribeAccountAttributes"),
+				jsii.String("ec2:DescribeAddresses"),
+				jsii.String("ec2:DescribeAvailabilityZones"),
+				jsii.String("ec2:DescribeInternetGateways"),
+				jsii.String("ec2:DescribeVpcs"),
+				jsii.String("ec2:DescribeVpcPeeringConnections"),
+				jsii.String("ec2:DescribeSubnets"),
+				jsii.String("ec2:DescribeSecurityGroups"),
+				jsii.String("ec2:DescribeInstances"),
+				jsii.String("ec2:DescribeNetworkInterfaces"),
+				jsii.String("ec2:DescribeTags"),
+				jsii.String("ec2:GetCoipPoolUsage"),
+				jsii.String("ec2:DescribeCoipPools"),
+				jsii.String("elasticloadbalancing:DescribeLoadBalancers"),
+				jsii.String("elasticloadbalancing:DescribeLoadBalancerAttributes"),
+				jsii.String("elasticloadbalancing:DescribeListeners"),
+				jsii.String("elasticloadbalancing:DescribeListenerCertificates"),
+				jsii.String("elasticloadbalancing:DescribeSSLPolicies"),
+				jsii.String("elasticloadbalancing:DescribeRules"),
+				jsii.String("elasticloadbalancing:DescribeTargetGroups"),
+				jsii.String("elasticloadbalancing:DescribeTargetGroupAttributes"),
+				jsii.String("elasticloadbalancing:DescribeTargetHealth"),
+				jsii.String("elasticloadbalancing:DescribeTags"),
+			},
+			Resources: &[]*string{jsii.String("*")},
+		}),
+		awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Effect: awsiam.Effect_ALLOW,
+			Actions: &[]*string{
+				jsii.String("cognito-idp:DescribeUserPoolClient"),
+				jsii.String("acm:ListCertificates"),
+				jsii.String("acm:DescribeCertificate"),
+				jsii.String("iam:ListServerCertificates"),
+				jsii.String("iam:GetServerCertificate"),
+				jsii.String("waf-regional:GetWebACL"),
+				jsii.String("waf-regional:GetWebACLForResource"),
+				jsii.String("waf-regional:AssociateWebACL"),
+				jsii.String("waf-regional:DisassociateWebACL"),
+				jsii.String("wafv2:GetWebACL"),
+				jsii.String("wafv2:GetWebACLForResource"),
+				jsii.String("wafv2:AssociateWebACL"),
+				jsii.String("wafv2:DisassociateWebACL"),
+				jsii.String("shield:GetSubscriptionState"),
+				jsii.String("shield:DescribeProtection"),
+				jsii.String("shield:CreateProtection"),
+				jsii.String("shield:DeleteProtection"),
+			},
+			Resources: &[]*string{jsii.String("*")},
+		}),
+		awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Effect: awsiam.Effect_ALLOW,
+			Actions: &[]*string{
+				jsii.String("ec2:AuthorizeSecurityGroupIngress"),
+				jsii.String("ec2:RevokeSecurityGroupIngress"),
+				jsii.String("ec2:CreateSecurityGroup"),
+			},
+			Resources: &[]*string{jsii.String("*")},
+		}),
+		awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Effect: awsiam.Effect_ALLOW,
+			Actions: &[]*string{
+				jsii.String("elasticloadbalancing:CreateLoadBalancer"),
+				jsii.String("elasticloadbalancing:CreateTargetGroup"),
+				jsii.String("elasticloadbalancing:CreateListener"),
+				jsii.String("elasticloadbalancing:DeleteListener"),
+				jsii.String("elasticloadbalancing:CreateRule"),
+				jsii.String("elasticloadbalancing:DeleteRule"),
+				jsii.String("elasticloadbalancing:AddTags"),
+				jsii.String("elasticloadbalancing:RemoveTags"),
+				jsii.String("elasticloadbalancing:ModifyLoadBalancerAttributes"),
+				jsii.String("elasticloadbalancing:SetIpAddressType"),
+				jsii.String("elasticloadbalancing:SetSecurityGroups"),
+				jsii.String("elasticloadbalancing:SetSubnets"),
+				jsii.String("elasticloadbalancing:DeleteLoadBalancer"),
+				jsii.String("elasticloadbalancing:ModifyTargetGroup"),
+				jsii.String("elasticloadbalancing:ModifyTargetGroupAttributes"),
+				jsii.String("elasticloadbalancing:DeleteTargetGroup"),
+				jsii.String("elasticloadbalancing:RegisterTargets"),
+				jsii.String("elasticloadbalancing:DeregisterTargets"),
+				jsii.String("elasticloadbalancing:SetWebAcl"),
+				jsii.String("elasticloadbalancing:ModifyListener"),
+				jsii.String("elasticloadbalancing:AddListenerCertificates"),
+				jsii.String("elasticloadbalancing:RemoveListenerCertificates"),
+				jsii.String("elasticloadbalancing:ModifyRule"),
+			},
+			Resources: &[]*string{jsii.String("*")},
+		}),
+	}
+}
@@ -0,0 +1,27 @@
+package stacks
+
+import (
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/constructs-go/constructs/v10"
+)
+
+type SkillTrailCoreStackProps struct {
+	awscdk.StackProps
+}
+
+func NewSkillTrailCoreStack(scope constructs.Construct, id string, props *SkillTrailCoreStackProps) awscdk.Stack {
+	var sprops awscdk.StackProps
+	if props != nil {
+		sprops = props.StackProps
+	}
+	stack := awscdk.NewStack(scope, &id, &sprops)
+
+	// The code that defines your stack goes here
+
+	// example resource
+	// queue := awssqs.NewQueue(stack, jsii.String("SkillTrailQueue"), &awssqs.QueueProps{
+	// 	VisibilityTimeout: awscdk.Duration_Seconds(jsii.Number(300)),
+	// })
+
+	return stack
+}
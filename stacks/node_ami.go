@@ -0,0 +1,32 @@
+package stacks
+
+import (
+	"context"
+	"fmt"
+
+	sdkaws "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	"github.com/ghezzofr/skilltrail-core/internal/awsclient"
+)
+
+// recommendedNodeAmiID looks up the AWS-published recommended EKS-optimized
+// AMI for kubernetesVersion via SSM at synth time, so the node group can pin
+// an explicit AMI instead of letting EKS resolve one implicitly on every
+// deploy. This is a real synth-time AWS call, so it's opt-in (see
+// SkillTrailPlatformStackProps.PinNodeAmi) rather than always-on.
+func recommendedNodeAmiID(ctx context.Context, localstack bool, kubernetesVersion string) (string, error) {
+	cfg, err := awsclient.LoadConfig(ctx, localstack)
+	if err != nil {
+		return "", fmt.Errorf("resolve node AMI: %w", err)
+	}
+
+	client := awsclient.NewSSMClient(cfg)
+	name := fmt.Sprintf("/aws/service/eks/optimized-ami/%s/amazon-linux-2/recommended/image_id", kubernetesVersion)
+	out, err := client.GetParameter(ctx, &ssm.GetParameterInput{Name: sdkaws.String(name)})
+	if err != nil {
+		return "", fmt.Errorf("resolve node AMI: get parameter %s: %w", name, err)
+	}
+
+	return sdkaws.ToString(out.Parameter.Value), nil
+}
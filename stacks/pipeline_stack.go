@@ -0,0 +1,113 @@
+package stacks
+
+import (
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/pipelines"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+
+	"github.com/ghezzofr/skilltrail-core/internal/config"
+)
+
+type SkillTrailPipelineStackProps struct {
+	awscdk.StackProps
+
+	// ConnectionArn is the CodeStar connection ARN used to source from
+	// GitHub, created once via the AWS Console or `aws codestar-connections
+	// create-connection`.
+	ConnectionArn string
+	// RepoString is "owner/repo" for the source repository.
+	RepoString string
+	// Branch is the branch the pipeline tracks. Defaults to "main".
+	Branch string
+}
+
+// NewSkillTrailPipelineStack wires a self-mutating CDK Pipelines delivery
+// pipeline that promotes SkillTrail Core through dev -> staging -> prod,
+// with a manual approval gate before prod. Unlike the other stacks in this
+// package, props is required: there's no sensible default source to pull
+// from, so a nil props panics rather than silently producing a brokenly
+// sourced pipeline.
+func NewSkillTrailPipelineStack(scope awscdk.App, id string, props *SkillTrailPipelineStackProps) awscdk.Stack {
+	if props == nil {
+		panic("stacks: NewSkillTrailPipelineStack requires props with ConnectionArn and RepoString set")
+	}
+
+	branch := "main"
+	if props.Branch != "" {
+		branch = props.Branch
+	}
+	stack := awscdk.NewStack(scope, &id, &props.StackProps)
+
+	source := pipelines.CodePipelineSource_Connection(jsii.String(props.RepoString), jsii.String(branch), &pipelines.ConnectionSourceOptions{
+		ConnectionArn: jsii.String(props.ConnectionArn),
+	})
+
+	synth := pipelines.NewShellStep(jsii.String("Synth"), &pipelines.ShellStepProps{
+		Input: source,
+		Commands: &[]*string{
+			jsii.String("go mod download"),
+			jsii.String("go test ./..."),
+			jsii.String("npx cdk synth"),
+		},
+		PrimaryOutputDirectory: jsii.String("cdk.out"),
+	})
+
+	pipeline := pipelines.NewCodePipeline(stack, jsii.String("Pipeline"), &pipelines.CodePipelineProps{
+		PipelineName: jsii.String("SkillTrailCorePipeline"),
+		Synth:        synth,
+	})
+
+	for _, name := range []config.Name{config.Dev, config.Staging} {
+		pipeline.AddStage(newSkillTrailStage(stack, string(name), scope, name), &pipelines.AddStageOpts{})
+	}
+
+	pipeline.AddStage(newSkillTrailStage(stack, string(config.Prod), scope, config.Prod), &pipelines.AddStageOpts{
+		Pre: &[]pipelines.Step{
+			pipelines.NewManualApprovalStep(jsii.String("PromoteToProd"), &pipelines.ManualApprovalStepProps{}),
+		},
+		Post: &[]pipelines.Step{
+			pipelines.NewShellStep(jsii.String("SmokeTest"), &pipelines.ShellStepProps{
+				Commands: &[]*string{
+					jsii.String("./scripts/smoke-test.sh"),
+				},
+			}),
+		},
+	})
+
+	return stack
+}
+
+// newSkillTrailStage wraps the core and platform stacks for a single
+// environment in an awscdk.Stage, so pipelines.CodePipeline can deploy them
+// together as one wave.
+func newSkillTrailStage(scope constructs.Construct, id string, app awscdk.App, name config.Name) awscdk.Stage {
+	stage := awscdk.NewStage(scope, &id, &awscdk.StageProps{})
+
+	env, err := config.Resolve(app, name)
+	if err != nil {
+		panic(err)
+	}
+
+	stackEnv := &awscdk.Environment{
+		Account: jsii.String(env.Account),
+		Region:  jsii.String(env.Region),
+	}
+
+	coreStack := NewSkillTrailCoreStack(stage, env.StackID("SkillTrailCore"), &SkillTrailCoreStackProps{
+		StackProps: awscdk.StackProps{Env: stackEnv},
+	})
+	awscdk.Tags_Of(coreStack).Add(jsii.String("environment"), jsii.String(string(env.Name)), nil)
+
+	platformStack := NewSkillTrailPlatformStack(stage, env.StackID("SkillTrailPlatform"), &SkillTrailPlatformStackProps{
+		StackProps:           awscdk.StackProps{Env: stackEnv},
+		NodeInstanceType:     env.NodeInstanceType,
+		NodeGroupMinSize:     env.NodeGroupMinSize,
+		NodeGroupMaxSize:     env.NodeGroupMaxSize,
+		NodeGroupDesiredSize: env.NodeGroupDesiredSize,
+		VpcCidr:              env.VpcCidr,
+	})
+	awscdk.Tags_Of(platformStack).Add(jsii.String("environment"), jsii.String(string(env.Name)), nil)
+
+	return stage
+}
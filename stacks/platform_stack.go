@@ -0,0 +1,180 @@
+package stacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awseks"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	kubectlv30 "github.com/cdklabs/awscdk-kubectl-go/kubectlv30/v2"
+)
+
+// KubernetesVersion pins the EKS control plane version. Bumping it (e.g.
+// 1.29 -> 1.30) means updating this constant and the kubectlv30 import above
+// together, since CDK EKS requires a matching kubectl provider layer.
+const KubernetesVersion = "1.30"
+
+type SkillTrailPlatformStackProps struct {
+	awscdk.StackProps
+
+	// NodeInstanceType is the EC2 instance type used by the managed node
+	// group. Defaults to "m5.large" when empty.
+	NodeInstanceType string
+	// NodeGroupMinSize, NodeGroupMaxSize and NodeGroupDesiredSize size the
+	// managed node group. Default to 2/4/2 when zero.
+	NodeGroupMinSize     float64
+	NodeGroupMaxSize     float64
+	NodeGroupDesiredSize float64
+
+	// VpcCidr is the CIDR block for the cluster's VPC. Defaults to
+	// "10.0.0.0/16" when empty; per-environment values come from
+	// internal/config so dev/staging/prod VPCs don't overlap if they're
+	// ever peered.
+	VpcCidr string
+
+	// Localstack, when true, skips constructs LocalStack's EKS emulation
+	// can't handle (the real cluster) in favor of a lightweight substitute,
+	// so `cdk synth` and `cdklocal deploy` both succeed on a developer
+	// laptop.
+	Localstack bool
+
+	// PinNodeAmi, when true, resolves the recommended EKS-optimized AMI for
+	// KubernetesVersion via SSM at synth time and pins the node group to it,
+	// instead of letting EKS pick the AMI implicitly on every deploy.
+	PinNodeAmi bool
+}
+
+// NewSkillTrailPlatformStack provisions the shared EKS cluster that
+// skilltrail workloads run on, along with the baseline observability and
+// ingress tooling every environment needs.
+func NewSkillTrailPlatformStack(scope constructs.Construct, id string, props *SkillTrailPlatformStackProps) awscdk.Stack {
+	var sprops awscdk.StackProps
+	nodeInstanceType := "m5.large"
+	minSize, maxSize, desiredSize := 2.0, 4.0, 2.0
+	vpcCidr := "10.0.0.0/16"
+	localstack := false
+	pinNodeAmi := false
+	if props != nil {
+		sprops = props.StackProps
+		if props.NodeInstanceType != "" {
+			nodeInstanceType = props.NodeInstanceType
+		}
+		if props.NodeGroupMinSize != 0 {
+			minSize = props.NodeGroupMinSize
+		}
+		if props.NodeGroupMaxSize != 0 {
+			maxSize = props.NodeGroupMaxSize
+		}
+		if props.NodeGroupDesiredSize != 0 {
+			desiredSize = props.NodeGroupDesiredSize
+		}
+		if props.VpcCidr != "" {
+			vpcCidr = props.VpcCidr
+		}
+		localstack = props.Localstack
+		pinNodeAmi = props.PinNodeAmi
+	}
+	stack := awscdk.NewStack(scope, &id, &sprops)
+
+	vpc := awsec2.NewVpc(stack, jsii.String("Vpc"), &awsec2.VpcProps{
+		MaxAzs:      jsii.Number(2),
+		IpAddresses: awsec2.IpAddresses_Cidr(jsii.String(vpcCidr)),
+	})
+
+	if localstack {
+		// Real EKS isn't supported by LocalStack's community edition. Stand
+		// up a VPC only, so downstream constructs that just need networking
+		// still synth and deploy cleanly with cdklocal.
+		awscdk.NewCfnOutput(stack, jsii.String("ClusterName"), &awscdk.CfnOutputProps{
+			Value: jsii.String("not-provisioned-under-localstack"),
+		})
+		return stack
+	}
+
+	cluster := awseks.NewCluster(stack, jsii.String("Cluster"), &awseks.ClusterProps{
+		Vpc:             vpc,
+		Version:         awseks.KubernetesVersion_Of(jsii.String(KubernetesVersion)),
+		KubectlLayer:    kubectlv30.NewKubectlV30Layer(stack, jsii.String("KubectlLayer")),
+		DefaultCapacity: jsii.Number(0),
+	})
+
+	nodegroupOptions := &awseks.NodegroupOptions{
+		InstanceTypes: &[]awsec2.InstanceType{awsec2.NewInstanceType(jsii.String(nodeInstanceType))},
+		MinSize:       jsii.Number(minSize),
+		MaxSize:       jsii.Number(maxSize),
+		DesiredSize:   jsii.Number(desiredSize),
+	}
+
+	if pinNodeAmi {
+		amiID, err := recommendedNodeAmiID(context.Background(), localstack, KubernetesVersion)
+		if err != nil {
+			panic(fmt.Errorf("stacks: %w", err))
+		}
+
+		launchTemplate := awsec2.NewLaunchTemplate(stack, jsii.String("NodeLaunchTemplate"), &awsec2.LaunchTemplateProps{
+			MachineImage: awsec2.MachineImage_GenericLinux(&map[string]*string{
+				*stack.Region(): jsii.String(amiID),
+			}, nil),
+		})
+		nodegroupOptions.LaunchTemplateSpec = &awseks.LaunchTemplateSpec{
+			Id:      launchTemplate.LaunchTemplateId(),
+			Version: launchTemplate.LatestVersionNumber(),
+		}
+	}
+
+	cluster.AddNodegroupCapacity(jsii.String("DefaultNodeGroup"), nodegroupOptions)
+
+	cluster.AddHelmChart(jsii.String("KubePrometheusStack"), &awseks.HelmChartOptions{
+		Chart:           jsii.String("kube-prometheus-stack"),
+		Repository:      jsii.String("https://prometheus-community.github.io/helm-charts"),
+		Namespace:       jsii.String("prometheus"),
+		CreateNamespace: jsii.Bool(true),
+	})
+
+	albServiceAccount := cluster.AddServiceAccount(jsii.String("AlbControllerServiceAccount"), &awseks.ServiceAccountOptions{
+		Name:      jsii.String("aws-load-balancer-controller"),
+		Namespace: jsii.String("kube-system"),
+	})
+	for _, statement := range albControllerPolicyStatements() {
+		albServiceAccount.AddToPrincipalPolicy(statement)
+	}
+
+	albChart := cluster.AddHelmChart(jsii.String("AwsLoadBalancerController"), &awseks.HelmChartOptions{
+		Chart:      jsii.String("aws-load-balancer-controller"),
+		Repository: jsii.String("https://aws.github.io/eks-charts"),
+		Namespace:  jsii.String("kube-system"),
+		Values: &map[string]interface{}{
+			"clusterName": cluster.ClusterName(),
+			"serviceAccount": map[string]interface{}{
+				"create": false,
+				"name":   "aws-load-balancer-controller",
+			},
+		},
+	})
+	albChart.Node().AddDependency(albServiceAccount)
+
+	cluster.AddHelmChart(jsii.String("CertManager"), &awseks.HelmChartOptions{
+		Chart:           jsii.String("cert-manager"),
+		Repository:      jsii.String("https://charts.jetstack.io"),
+		Namespace:       jsii.String("cert-manager"),
+		CreateNamespace: jsii.Bool(true),
+		Values: &map[string]interface{}{
+			"installCRDs": true,
+		},
+	})
+
+	awscdk.NewCfnOutput(stack, jsii.String("ClusterName"), &awscdk.CfnOutputProps{
+		Value: cluster.ClusterName(),
+	})
+	awscdk.NewCfnOutput(stack, jsii.String("ClusterOidcIssuer"), &awscdk.CfnOutputProps{
+		Value: cluster.ClusterOpenIdConnectIssuerUrl(),
+	})
+	awscdk.NewCfnOutput(stack, jsii.String("ClusterKubeconfigRole"), &awscdk.CfnOutputProps{
+		Value: cluster.KubectlRole().RoleArn(),
+	})
+
+	return stack
+}
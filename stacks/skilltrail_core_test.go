@@ -0,0 +1,130 @@
+package stacks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
+	"github.com/aws/jsii-runtime-go"
+)
+
+func testEnv() *awscdk.Environment {
+	return &awscdk.Environment{
+		Account: jsii.String("123456789012"),
+		Region:  jsii.String("eu-south-1"),
+	}
+}
+
+func synthPlatformTemplate(t *testing.T) assertions.Template {
+	t.Helper()
+	app := awscdk.NewApp(nil)
+	stack := NewSkillTrailPlatformStack(app, "TestSkillTrailPlatformStack", &SkillTrailPlatformStackProps{
+		StackProps: awscdk.StackProps{Env: testEnv()},
+	})
+	return assertions.Template_FromStack(stack, nil)
+}
+
+func TestPlatformStackHasPinnedEksVersion(t *testing.T) {
+	template := synthPlatformTemplate(t)
+
+	template.HasResourceProperties(jsii.String("Custom::AWSCDK-EKS-Cluster"), map[string]interface{}{
+		"Config": map[string]interface{}{
+			"version": KubernetesVersion,
+		},
+	})
+}
+
+func TestPlatformClusterRoleTrustsEks(t *testing.T) {
+	template := synthPlatformTemplate(t)
+
+	template.HasResourceProperties(jsii.String("AWS::IAM::Role"), map[string]interface{}{
+		"AssumeRolePolicyDocument": assertions.Match_ObjectLike(&map[string]interface{}{
+			"Statement": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Principal": map[string]interface{}{
+						"Service": "eks.amazonaws.com",
+					},
+				}),
+			}),
+		}),
+	})
+}
+
+func TestPlatformNodeRoleTrustsEc2(t *testing.T) {
+	template := synthPlatformTemplate(t)
+
+	template.HasResourceProperties(jsii.String("AWS::IAM::Role"), map[string]interface{}{
+		"AssumeRolePolicyDocument": assertions.Match_ObjectLike(&map[string]interface{}{
+			"Statement": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Principal": map[string]interface{}{
+						"Service": "ec2.amazonaws.com",
+					},
+				}),
+			}),
+		}),
+	})
+}
+
+// TestNoUnencryptedBuckets and TestNoWildcardIamActions are generic
+// guardrails that run against the platform stack's synthesized template.
+// They're not run against the core stack, which doesn't define any
+// resources yet.
+
+func TestNoUnencryptedBuckets(t *testing.T) {
+	template := synthPlatformTemplate(t)
+
+	raw := *template.ToJSON()
+	resources, _ := raw["Resources"].(map[string]interface{})
+	for logicalID, res := range resources {
+		resource, ok := res.(map[string]interface{})
+		if !ok || resource["Type"] != "AWS::S3::Bucket" {
+			continue
+		}
+		props, _ := resource["Properties"].(map[string]interface{})
+		if _, ok := props["BucketEncryption"]; !ok {
+			t.Errorf("bucket %s has no BucketEncryption configured", logicalID)
+		}
+	}
+}
+
+func TestNoWildcardIamActions(t *testing.T) {
+	template := synthPlatformTemplate(t)
+
+	raw := *template.ToJSON()
+	resources, _ := raw["Resources"].(map[string]interface{})
+	for logicalID, res := range resources {
+		resource, ok := res.(map[string]interface{})
+		if !ok || resource["Type"] != "AWS::IAM::Policy" {
+			continue
+		}
+		props, _ := resource["Properties"].(map[string]interface{})
+		doc, _ := props["PolicyDocument"].(map[string]interface{})
+		statements, _ := doc["Statement"].([]interface{})
+		for _, s := range statements {
+			statement, ok := s.(map[string]interface{})
+			if !ok || statement["Effect"] != "Allow" {
+				continue
+			}
+			if hasWildcardAction(statement["Action"]) {
+				b, _ := json.Marshal(statement)
+				t.Errorf("policy %s allows a wildcard action: %s", logicalID, b)
+			}
+		}
+	}
+}
+
+func hasWildcardAction(action interface{}) bool {
+	switch a := action.(type) {
+	case string:
+		return a == "*"
+	case []interface{}:
+		for _, v := range a {
+			if s, ok := v.(string); ok && s == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}